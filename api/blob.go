@@ -0,0 +1,72 @@
+// Package api provides RPC client bindings for AIStore clusters.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// BlobStatusResult reports how much of a resumable blob download (see
+// xs.xactBlobDl) is already on disk, e.g. to seed a CLI progress bar across
+// a resume without it appearing to restart from zero.
+type BlobStatusResult struct {
+	Bytes     int64 `json:"bytes"`
+	TotalSize int64 `json:"total_size"`
+}
+
+func blobPath(bck cmn.Bck, objName string) string {
+	return "/v1/blob-download/" + bck.Cname(objName)
+}
+
+// BlobStatus returns the on-disk checkpoint progress for an in-flight (or
+// already finished) blob download of bck/objName.
+func BlobStatus(bp BaseParams, bck cmn.Bck, objName string) (*BlobStatusResult, error) {
+	req, err := http.NewRequest(http.MethodGet, bp.URL+blobPath(bck, objName), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := doReq(bp, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("blob status %s: server returned %s", bck.Cname(objName), resp.Status)
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	status := &BlobStatusResult{}
+	if err := json.Unmarshal(b, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// BlobDiscardCheckpoint drops the on-disk resume checkpoint (if any) for
+// bck/objName, e.g. to honor `ais blob download --force-restart`: the next
+// `api.BlobDownload` call for the same object then has nothing to resume
+// from and starts over.
+func BlobDiscardCheckpoint(bp BaseParams, bck cmn.Bck, objName string) error {
+	req, err := http.NewRequest(http.MethodDelete, bp.URL+blobPath(bck, objName), http.NoBody)
+	if err != nil {
+		return err
+	}
+	resp, err := doReq(bp, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("blob discard-checkpoint %s: server returned %s", bck.Cname(objName), resp.Status)
+	}
+	return nil
+}
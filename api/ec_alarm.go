@@ -0,0 +1,71 @@
+// Package api provides RPC client bindings for AIStore clusters.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/NVIDIA/aistore/cmn"
+)
+
+// ECAlarm mirrors ec.ecAlarm (kept as a separate, minimal type here rather
+// than importing the ec package, same as other api types that shadow their
+// target-side counterpart across the client/server boundary).
+type ECAlarm struct {
+	Kind   string `json:"kind"`
+	Detail string `json:"detail,omitempty"`
+}
+
+func ecAlarmPath(bck cmn.Bck) string {
+	return "/v1/ec/" + bck.Cname("") + "/alarm"
+}
+
+// ECAlarmList returns the alarms (if any) currently keeping bck's EC
+// get/put xactions in degraded mode.
+func ECAlarmList(bp BaseParams, bck cmn.Bck) ([]ECAlarm, error) {
+	req, err := http.NewRequest(http.MethodGet, bp.URL+ecAlarmPath(bck), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := doReq(bp, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("ec alarm list %s: server returned %s", bck.Cname(""), resp.Status)
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var alarms []ECAlarm
+	if err := json.Unmarshal(b, &alarms); err != nil {
+		return nil, err
+	}
+	return alarms, nil
+}
+
+// ECAlarmDisarm clears every active EC alarm for bck, taking its get/put
+// xactions out of degraded mode without waiting for the condition to clear
+// on its own.
+func ECAlarmDisarm(bp BaseParams, bck cmn.Bck) error {
+	req, err := http.NewRequest(http.MethodDelete, bp.URL+ecAlarmPath(bck), http.NoBody)
+	if err != nil {
+		return err
+	}
+	resp, err := doReq(bp, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("ec alarm disarm %s: server returned %s", bck.Cname(""), resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+// Package api provides RPC client bindings for AIStore clusters.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ext/etl"
+)
+
+// ETLInit starts a new inline ETL session (`apc.ActETLInline`) from spec,
+// returning the xaction ID the client uses for subsequent status/stop/
+// keep-alive calls. When leaseTTL is non-zero the session is started with
+// a TTL-bound lease (see xs.xactETL.Grant) that must be renewed via
+// api.ETLKeepAlive before it expires, or the target aborts it and releases
+// its pods on its own. This is the client-side counterpart of
+// `ais etl init --lease=<duration>`.
+func ETLInit(bp BaseParams, spec *etl.InitSpecMsg, leaseTTL cos.Duration) (xid string, err error) {
+	body, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	url := bp.URL + "/v1/etl/init"
+	if leaseTTL > 0 {
+		url += "?lease=" + time.Duration(leaseTTL).String()
+	}
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := doReq(bp, req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return "", fmt.Errorf("etl init: server returned %s", resp.Status)
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,46 @@
+// Package api provides RPC client bindings for AIStore clusters.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package api
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ETLKeepAlive renews the TTL-bound lease (see xs.xactETL.Grant/KeepAlive)
+// of an inline ETL session identified by uuid. A client that doesn't call
+// this periodically lets the lease expire, triggering the target's
+// global-abort cleanup for that session. This is the client-side
+// counterpart of `ais etl keepalive <id>`.
+func ETLKeepAlive(bp BaseParams, uuid string) error {
+	req, err := http.NewRequest(http.MethodPost, bp.URL+"/v1/etl/"+uuid+"/keepalive", http.NoBody)
+	if err != nil {
+		return err
+	}
+	resp, err := doReq(bp, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("etl keepalive %q: server returned %s", uuid, resp.Status)
+	}
+	return nil
+}
+
+// doReq issues req against bp's client (defaulting to http.DefaultClient),
+// attaching bp's auth token if set. Kept here - rather than as a method on
+// the (pre-existing, elsewhere-defined) BaseParams - so this package's new
+// api functions don't assume anything about BaseParams beyond its fields.
+func doReq(bp BaseParams, req *http.Request) (*http.Response, error) {
+	client := bp.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if bp.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+bp.Token)
+	}
+	return client.Do(req)
+}
@@ -22,6 +22,15 @@ import (
 	"github.com/vbauerster/mpb/v4"
 )
 
+// blobForceRestartFlag discards any on-disk resume checkpoint (see
+// xs.xactBlobDl) before starting the download, instead of picking up where
+// a prior attempt left off - which is otherwise always the default when a
+// matching checkpoint is found for an unchanged remote object.
+var blobForceRestartFlag = cli.BoolFlag{
+	Name:  "force-restart",
+	Usage: "discard any existing resume checkpoint and download the object from scratch",
+}
+
 func blobDownloadHandler(c *cli.Context) error {
 	var (
 		objNames []string
@@ -61,6 +70,14 @@ func blobDownloadHandler(c *cli.Context) error {
 	}
 	msg.LatestVer = flagIsSet(c, latestVerFlag)
 
+	if flagIsSet(c, blobForceRestartFlag) {
+		for _, objName := range objNames {
+			if err := api.BlobDiscardCheckpoint(apiBP, bck, objName); err != nil {
+				return V(err)
+			}
+		}
+	}
+
 	// start
 	var (
 		xids    []string
@@ -178,10 +195,10 @@ func blobAllProgress(c *cli.Context, bck cmn.Bck, objNames, xids []string) (err
 	progress, bars := simpleBar(bargs...)
 	for i := range objNames {
 		if xids[i] != "" {
-			xid, bar := xids[i], bars[i]
+			xid, bar, objName := xids[i], bars[i], objNames[i]
 			cname := xact.Cname(apc.ActBlobDl, xid)
 			fmt.Fprintln(c.App.Writer, fcyan(cname))
-			go _blobOneProgress(xid, bar, errCh, refreshRate)
+			go _blobOneProgress(bck, objName, xid, bar, errCh, refreshRate)
 		}
 	}
 	progress.Wait()
@@ -195,13 +212,24 @@ func blobAllProgress(c *cli.Context, bck cmn.Bck, objNames, xids []string) (err
 	return err
 }
 
-func _blobOneProgress(xid string, bar *mpb.Bar, errCh chan error, sleep time.Duration) {
+func _blobOneProgress(bck cmn.Bck, objName, xid string, bar *mpb.Bar, errCh chan error, sleep time.Duration) {
 	var (
 		xargs    = xact.ArgsMsg{ID: xid, Kind: apc.ActBlobDl}
 		currSize int64
 		fullSize = int64(-1)
 		done     bool
 	)
+	// resume: seed the bar from the on-disk checkpoint (chunk bitmap,
+	// ETag/version, total size) so a resumed download doesn't appear to
+	// restart from zero
+	if status, err := api.BlobStatus(apiBP, bck, objName); err == nil && status.Bytes > 0 {
+		currSize = status.Bytes
+		bar.SetCurrent(currSize)
+		if status.TotalSize > 0 {
+			fullSize = status.TotalSize
+			bar.SetTotal(fullSize, false)
+		}
+	}
 	for {
 		daemonID, snap, errN := getAnyXactSnap(&xargs)
 		if errN != nil {
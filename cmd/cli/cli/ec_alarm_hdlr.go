@@ -0,0 +1,71 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles commands to inspect and clear EC degraded-mode alarms.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/urfave/cli"
+)
+
+// ecAlarmCmd is `ais storage ec alarm`. TODO: splice into the `storage ec`
+// command tree (not part of this checkout) to expose it.
+var ecAlarmCmd = cli.Command{
+	Name:  "alarm",
+	Usage: "show or clear EC degraded-mode alarms for a bucket",
+	Subcommands: []cli.Command{
+		{
+			Name:      "list",
+			Usage:     "list active EC alarms for a bucket",
+			ArgsUsage: bucketArgument,
+			Action:    ecAlarmListHandler,
+		},
+		{
+			Name:      "disarm",
+			Usage:     "clear all active EC alarms for a bucket, taking it out of degraded mode",
+			ArgsUsage: bucketArgument,
+			Action:    ecAlarmDisarmHandler,
+		},
+	},
+}
+
+// ecAlarmListHandler implements `ais storage ec alarm list`: shows the
+// alarms (if any) currently keeping a bucket's EC get/put xactions in
+// degraded mode, mirroring etcd's `etcdctl alarm list`.
+func ecAlarmListHandler(c *cli.Context) error {
+	bck, err := parseBckURI(c, c.Args().Get(0), true)
+	if err != nil {
+		return err
+	}
+	alarms, err := api.ECAlarmList(apiBP, bck)
+	if err != nil {
+		return V(err)
+	}
+	if len(alarms) == 0 {
+		fmt.Fprintln(c.App.Writer, "No active EC alarms")
+		return nil
+	}
+	for _, a := range alarms {
+		fmt.Fprintf(c.App.Writer, "%s\t%s\n", a.Kind, a.Detail)
+	}
+	return nil
+}
+
+// ecAlarmDisarmHandler implements `ais storage ec alarm disarm`: clears
+// every active alarm for a bucket's EC xactions, taking them out of
+// degraded mode without waiting for the condition to clear on its own.
+func ecAlarmDisarmHandler(c *cli.Context) error {
+	bck, err := parseBckURI(c, c.Args().Get(0), true)
+	if err != nil {
+		return err
+	}
+	if err := api.ECAlarmDisarm(apiBP, bck); err != nil {
+		return V(err)
+	}
+	actionDone(c, fmt.Sprintf("Disarmed EC alarms for %s", bck.Cname("")))
+	return nil
+}
@@ -0,0 +1,57 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles `ais etl init`.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/ext/etl"
+	"github.com/urfave/cli"
+)
+
+// etlInitCmd starts an inline ETL session from a spec file. etlLeaseFlag
+// (see etl_lease_hdlr.go) is the only flag this checkout wires up; the
+// rest of `ais etl init`'s real flag set lives outside this checkout.
+// TODO: append to the `etl` command's Subcommands alongside
+// etlKeepAliveCmd - that parent command tree isn't part of this checkout.
+var etlInitCmd = cli.Command{
+	Name:      "init",
+	Usage:     "start an inline ETL job from a spec file",
+	ArgsUsage: "SPEC_FILE",
+	Flags:     []cli.Flag{etlLeaseFlag},
+	Action:    etlInitHandler,
+}
+
+func etlInitHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	fqn := c.Args().Get(0)
+	b, err := os.ReadFile(fqn)
+	if err != nil {
+		return err
+	}
+	spec := &etl.InitSpecMsg{}
+	if err := json.Unmarshal(b, spec); err != nil {
+		return err
+	}
+
+	var leaseTTL cos.Duration
+	if flagIsSet(c, etlLeaseFlag) {
+		leaseTTL = cos.Duration(parseDurationFlag(c, etlLeaseFlag))
+	}
+
+	xid, err := api.ETLInit(apiBP, spec, leaseTTL)
+	if err != nil {
+		return V(err)
+	}
+	fmt.Fprintln(c.App.Writer, xid)
+	return nil
+}
@@ -0,0 +1,46 @@
+// Package cli provides easy-to-use commands to manage, monitor, and utilize AIS clusters.
+// This file handles lease/keep-alive commands for long-running inline ETL sessions.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package cli
+
+import (
+	"fmt"
+
+	"github.com/NVIDIA/aistore/api"
+	"github.com/urfave/cli"
+)
+
+// etlLeaseFlag attaches a TTL-bound lease to an inline ETL job started via
+// `ais etl init` (see etl_init_hdlr.go).
+var etlLeaseFlag = cli.DurationFlag{
+	Name: "lease",
+	Usage: "attach a TTL-bound lease to the ETL job; the job is aborted " +
+		"(and its pods released) if not renewed via 'ais etl keepalive' before the lease expires",
+}
+
+// etlKeepAliveCmd is `ais etl keepalive <id>`. TODO: append to the `etl`
+// command's Subcommands (not part of this checkout) to expose it.
+var etlKeepAliveCmd = cli.Command{
+	Name:      "keepalive",
+	Usage:     "renew the lease on a running inline ETL job",
+	ArgsUsage: "ETL_ID",
+	Action:    etlKeepAliveHandler,
+}
+
+// etlKeepAliveHandler implements `ais etl keepalive <id>`: renews the
+// TTL-bound lease of an inline ETL session started with `ais etl init
+// --lease=<duration>`, the CLI counterpart of the lessor goroutine's
+// global-abort-on-expiry cleanup in `xs.xactETL`.
+func etlKeepAliveHandler(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return missingArgumentsError(c, c.Command.ArgsUsage)
+	}
+	uuid := c.Args().Get(0)
+	if err := api.ETLKeepAlive(apiBP, uuid); err != nil {
+		return V(err)
+	}
+	fmt.Fprintf(c.App.Writer, "Lease for ETL job %q renewed\n", uuid)
+	return nil
+}
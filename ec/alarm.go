@@ -0,0 +1,249 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+* Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Alarm subsystem for EC get/put xactions, inspired by etcd's alarm
+// mechanism: once a threshold is crossed, the corresponding alarm is
+// raised and the owning xaction enters degraded mode (see
+// XactGet.dispatchReq / XactPut.dispatchReq) until the condition drops
+// back below a lower "low-water" threshold, with hysteresis in between
+// to prevent flapping. Thresholds are kept as unexported consts rather
+// than `config.EC` knobs since they're an internal throttling detail, not
+// something operators are expected to tune per cluster.
+
+type (
+	// alarm kind identifiers, surfaced via `Snap().Ext` and `ais show ec`
+	ecAlarmKind string
+
+	ecAlarm struct {
+		Kind   ecAlarmKind `json:"kind"`
+		Raised time.Time   `json:"raised"`
+		Detail string      `json:"detail,omitempty"`
+	}
+
+	// ecAlarms tracks the set of currently active alarms for a single EC
+	// get or put xaction. Safe for concurrent use by joggers and the
+	// xaction's own dispatch/stats paths.
+	ecAlarms struct {
+		mtx    sync.Mutex
+		active map[ecAlarmKind]*ecAlarm
+	}
+
+	// ErrorECDegraded is returned instead of silently queuing a restore
+	// (XactGet) or encode (XactPut) request while one or more alarms are
+	// active.
+	ErrorECDegraded struct {
+		alarms []ecAlarm
+	}
+)
+
+const (
+	ECAlarmSliceTimeout  ecAlarmKind = "slice-timeout"
+	ECAlarmMpathLoss     ecAlarmKind = "mpath-loss"
+	ECAlarmDecodeErrRate ecAlarmKind = "decode-err-rate"
+	ECAlarmQueueOverflow ecAlarmKind = "queue-overflow"
+
+	// new joggers created while degraded get a fraction of the usual
+	// burst size; already-running joggers are throttled via the
+	// queue-overflow check in dispatchReq instead, since their channel
+	// buffers can't be resized in place
+	ecDegradedBurstDivisor = 4
+
+	// hysteresis band for ECAlarmDecodeErrRate (GET-side decode, PUT-side
+	// encode): raised once the rolling error rate reaches the high-water
+	// mark, cleared once it drops back under the low-water mark.
+	ecErrRateHigh = 0.10
+	ecErrRateLow  = 0.02
+
+	// hysteresis band for ECAlarmSliceTimeout: raised once a mountpath's
+	// slice queue has stayed saturated at least this long, cleared once
+	// it's been drained for at least the low-water duration.
+	ecSliceTimeoutHigh = 30 * time.Second
+	ecSliceTimeoutLow  = 5 * time.Second
+)
+
+func (e *ErrorECDegraded) Error() string {
+	kinds := make([]string, 0, len(e.alarms))
+	for _, a := range e.alarms {
+		kinds = append(kinds, string(a.Kind))
+	}
+	return fmt.Sprintf("EC is in degraded mode (%s), rejecting request", strings.Join(kinds, ", "))
+}
+
+func (a *ecAlarms) init() { a.active = make(map[ecAlarmKind]*ecAlarm) }
+
+// raise activates the alarm if not already active; returns true the
+// first time (i.e. on the 0->1 transition) so callers can log once.
+func (a *ecAlarms) raise(kind ecAlarmKind, detail string) bool {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	if _, ok := a.active[kind]; ok {
+		return false
+	}
+	a.active[kind] = &ecAlarm{Kind: kind, Raised: time.Now(), Detail: detail}
+	return true
+}
+
+// clear disarms the alarm, returning true iff it was active.
+func (a *ecAlarms) clear(kind ecAlarmKind) bool {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	if _, ok := a.active[kind]; !ok {
+		return false
+	}
+	delete(a.active, kind)
+	return true
+}
+
+func (a *ecAlarms) isActive(kind ecAlarmKind) bool {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	_, ok := a.active[kind]
+	return ok
+}
+
+// degraded reports whether any alarm is currently active.
+func (a *ecAlarms) degraded() bool {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	return len(a.active) > 0
+}
+
+func (a *ecAlarms) list() []ecAlarm {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	out := make([]ecAlarm, 0, len(a.active))
+	for _, al := range a.active {
+		out = append(out, *al)
+	}
+	return out
+}
+
+// disarm clears every active alarm, e.g. via `ais storage ec alarm disarm`.
+func (a *ecAlarms) disarm() {
+	a.mtx.Lock()
+	a.active = make(map[ecAlarmKind]*ecAlarm)
+	a.mtx.Unlock()
+}
+
+// decodeErrRate is a small rolling counter shared by the GET (decode) and
+// PUT (encode) xactions to raise/clear ECAlarmDecodeErrRate with
+// hysteresis.
+type decodeErrRate struct {
+	mtx     sync.Mutex
+	errs, n int64
+}
+
+func (d *decodeErrRate) update(isErr bool) (rate float64) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	d.n++
+	if isErr {
+		d.errs++
+	}
+	// halve both counts periodically so the rate reflects recent
+	// behavior, not the entire lifetime of the xaction
+	const window = 10_000
+	if d.n >= window {
+		d.errs, d.n = d.errs/2, d.n/2
+	}
+	if d.n == 0 {
+		return 0
+	}
+	return float64(d.errs) / float64(d.n)
+}
+
+// checkDecodeErrRate updates the rolling error rate and raises/clears
+// ECAlarmDecodeErrRate against the `ecErrRateHigh`/`ecErrRateLow`
+// hysteresis band. A free function (rather than an *XactGet method) so
+// XactPut's encode path can feed it too.
+func checkDecodeErrRate(alarms *ecAlarms, roll *decodeErrRate, isErr bool) {
+	rate := roll.update(isErr)
+	switch {
+	case rate >= ecErrRateHigh:
+		alarms.raise(ECAlarmDecodeErrRate, fmt.Sprintf("rate=%.3f", rate))
+	case rate < ecErrRateLow:
+		alarms.clear(ECAlarmDecodeErrRate)
+	}
+}
+
+// sliceTimeoutTracker records, per mountpath, how long its slice queue has
+// been continuously saturated (see markBusy/markIdle), feeding
+// checkSliceTimeout's ECAlarmSliceTimeout hysteresis. Shared by the GET and
+// PUT xactions, one instance each.
+type sliceTimeoutTracker struct {
+	mtx   sync.Mutex
+	since map[string]time.Time
+}
+
+func (t *sliceTimeoutTracker) init() { t.since = make(map[string]time.Time) }
+
+// markBusy records the start of a mountpath's queue-saturated period, if
+// it isn't already marked; idempotent while the mountpath stays saturated.
+func (t *sliceTimeoutTracker) markBusy(mpath string) {
+	t.mtx.Lock()
+	if _, ok := t.since[mpath]; !ok {
+		t.since[mpath] = time.Now()
+	}
+	t.mtx.Unlock()
+}
+
+// markIdle clears a mountpath's saturated-since marker once its queue has
+// drained.
+func (t *sliceTimeoutTracker) markIdle(mpath string) {
+	t.mtx.Lock()
+	delete(t.since, mpath)
+	t.mtx.Unlock()
+}
+
+// oldest returns how long the longest-saturated mountpath (if any) has
+// been continuously saturated.
+func (t *sliceTimeoutTracker) oldest() time.Duration {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	var longest time.Duration
+	for _, since := range t.since {
+		if d := time.Since(since); d > longest {
+			longest = d
+		}
+	}
+	return longest
+}
+
+// checkSliceTimeout raises/clears ECAlarmSliceTimeout against the
+// `ecSliceTimeoutHigh`/`ecSliceTimeoutLow` hysteresis band.
+func checkSliceTimeout(alarms *ecAlarms, tracker *sliceTimeoutTracker) {
+	d := tracker.oldest()
+	switch {
+	case d >= ecSliceTimeoutHigh:
+		alarms.raise(ECAlarmSliceTimeout, fmt.Sprintf("saturated for %s", d))
+	case d < ecSliceTimeoutLow:
+		alarms.clear(ECAlarmSliceTimeout)
+	}
+}
+
+// clearQueueOverflow auto-clears ECAlarmQueueOverflow once every one of the
+// given per-mountpath queue lengths has drained back below lowWater,
+// providing the hysteresis to match the high-water raise in dispatchReq.
+// A free function (rather than an *XactGet method) so XactPut's own
+// dispatch loop can reuse it against its own joggers.
+func clearQueueOverflow(alarms *ecAlarms, qlens []int, lowWater int) {
+	if !alarms.isActive(ECAlarmQueueOverflow) {
+		return
+	}
+	for _, qlen := range qlens {
+		if qlen >= lowWater {
+			return
+		}
+	}
+	alarms.clear(ECAlarmQueueOverflow)
+}
@@ -0,0 +1,121 @@
+// Package ec provides erasure coding (EC) based data protection for AIStore.
+/*
+* Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package ec
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEcAlarms_RaiseClearIsActiveDegraded(t *testing.T) {
+	var a ecAlarms
+	a.init()
+
+	if a.degraded() {
+		t.Fatal("expected no alarms active initially")
+	}
+	if !a.raise(ECAlarmMpathLoss, "mpath gone") {
+		t.Fatal("expected the first raise to report a 0->1 transition")
+	}
+	if a.raise(ECAlarmMpathLoss, "mpath gone") {
+		t.Fatal("expected a second raise of the same alarm to report no transition")
+	}
+	if !a.isActive(ECAlarmMpathLoss) || !a.degraded() {
+		t.Fatal("expected the alarm to be active and the xaction degraded")
+	}
+	if !a.clear(ECAlarmMpathLoss) {
+		t.Fatal("expected clear to report the alarm was active")
+	}
+	if a.isActive(ECAlarmMpathLoss) || a.degraded() {
+		t.Fatal("expected the alarm to be cleared")
+	}
+}
+
+func TestEcAlarms_Disarm(t *testing.T) {
+	var a ecAlarms
+	a.init()
+	a.raise(ECAlarmMpathLoss, "")
+	a.raise(ECAlarmQueueOverflow, "")
+	a.disarm()
+	if a.degraded() || len(a.list()) != 0 {
+		t.Fatal("expected disarm to clear every active alarm")
+	}
+}
+
+func TestDecodeErrRate_HysteresisRaiseAndClear(t *testing.T) {
+	var (
+		a    ecAlarms
+		roll decodeErrRate
+	)
+	a.init()
+
+	for i := 0; i < 100; i++ {
+		checkDecodeErrRate(&a, &roll, true)
+	}
+	if !a.isActive(ECAlarmDecodeErrRate) {
+		t.Fatal("expected a 100% error rate to raise the alarm")
+	}
+
+	for i := 0; i < 1000; i++ {
+		checkDecodeErrRate(&a, &roll, false)
+	}
+	if a.isActive(ECAlarmDecodeErrRate) {
+		t.Fatal("expected the error rate to drop back below the low-water mark and clear")
+	}
+}
+
+func TestSliceTimeoutTracker_MarkBusyIdleOldest(t *testing.T) {
+	var tr sliceTimeoutTracker
+	tr.init()
+
+	if tr.oldest() != 0 {
+		t.Fatal("expected zero with nothing marked busy")
+	}
+	tr.markBusy("/mp1")
+	if tr.oldest() <= 0 {
+		t.Fatal("expected a positive duration once a mountpath is marked busy")
+	}
+	tr.markIdle("/mp1")
+	if tr.oldest() != 0 {
+		t.Fatal("expected zero again once the mountpath is marked idle")
+	}
+}
+
+func TestCheckSliceTimeout_HysteresisRaiseAndClear(t *testing.T) {
+	var (
+		a  ecAlarms
+		tr sliceTimeoutTracker
+	)
+	a.init()
+	tr.init()
+	tr.since = map[string]time.Time{"/mp1": time.Now().Add(-2 * ecSliceTimeoutHigh)}
+
+	checkSliceTimeout(&a, &tr)
+	if !a.isActive(ECAlarmSliceTimeout) {
+		t.Fatal("expected a long-saturated mountpath to raise the alarm")
+	}
+
+	tr.markIdle("/mp1")
+	checkSliceTimeout(&a, &tr)
+	if a.isActive(ECAlarmSliceTimeout) {
+		t.Fatal("expected the alarm to clear once no mountpath is saturated")
+	}
+}
+
+func TestClearQueueOverflow(t *testing.T) {
+	var a ecAlarms
+	a.init()
+	a.raise(ECAlarmQueueOverflow, "")
+
+	clearQueueOverflow(&a, []int{5, 6}, 4)
+	if !a.isActive(ECAlarmQueueOverflow) {
+		t.Fatal("expected the alarm to stay active while a queue is still at/above low-water")
+	}
+
+	clearQueueOverflow(&a, []int{1, 2}, 4)
+	if a.isActive(ECAlarmQueueOverflow) {
+		t.Fatal("expected the alarm to clear once every queue has drained below low-water")
+	}
+}
@@ -37,7 +37,10 @@ type (
 	XactGet struct {
 		xactECBase
 		xactReqBase
-		getJoggers map[string]*getJogger // mountpath joggers for GET
+		getJoggers    map[string]*getJogger // mountpath joggers for GET
+		alarms        ecAlarms
+		decodeErrs    decodeErrRate
+		sliceTimeouts sliceTimeoutTracker
 	}
 
 	// extended x-ec-get statistics
@@ -47,6 +50,7 @@ type (
 		AvgObjTime  cos.Duration `json:"ec.obj.process.ns"`
 		AvgQueueLen float64      `json:"ec.queue.len.f"`
 		IsIdle      bool         `json:"is_idle"`
+		Alarms      []ecAlarm    `json:"ec.alarms,omitempty"`
 	}
 )
 
@@ -89,6 +93,8 @@ func newGetXact(bck *cmn.Bck, mgr *Manager) *XactGet {
 	xctn := &XactGet{}
 	xctn.xactECBase.init(cmn.GCO.Get(), bck, mgr)
 	xctn.xactReqBase.init()
+	xctn.alarms.init()
+	xctn.sliceTimeouts.init()
 
 	// constuct joggers
 	avail, disabled := fs.Get()
@@ -126,7 +132,9 @@ func (r *XactGet) dispatchResp(iReq intraReq, hdr *transport.ObjHdr, bck *meta.B
 			r.AddErr(err, 0)
 			return
 		}
-		if err := _writerReceive(writer, iReq.exists, objAttrs, reader); err != nil {
+		err := _writerReceive(writer, iReq.exists, objAttrs, reader)
+		checkDecodeErrRate(&r.alarms, &r.decodeErrs, err != nil)
+		if err != nil {
 			errN := fmt.Errorf("%s: failed to read %s replica: %w", core.T, bck.Cname(objName), err)
 			r.AddErr(errN, 0)
 			if err == io.ErrUnexpectedEOF || errors.Is(err, io.ErrUnexpectedEOF) {
@@ -153,7 +161,7 @@ func (r *XactGet) newGetJogger(mpath string) *getJogger {
 		parent: r,
 		mpath:  mpath,
 		client: client,
-		workCh: make(chan *request, max(getxBurstSize, r.config.EC.Burst)),
+		workCh: make(chan *request, r.effectiveBurst()),
 	}
 	j.stopCh.Init()
 	return j
@@ -170,18 +178,47 @@ func (r *XactGet) dispatchReq(req *request, lom *core.LOM) error {
 
 	debug.Assert(req.Action == ActRestore)
 
+	if r.alarms.degraded() {
+		err := &ErrorECDegraded{alarms: r.alarms.list()}
+		if req.ErrCh != nil {
+			req.ErrCh <- err
+			close(req.ErrCh)
+		}
+		return err
+	}
+
 	jogger, ok := r.getJoggers[lom.Mountpath().Path]
 	if !ok {
 		err := errLossMpath(r, lom)
+		r.alarms.raise(ECAlarmMpathLoss, err.Error())
 		r.Abort(err)
 		return err
 	}
 
-	r.stats.updateQueue(len(jogger.workCh))
+	qlen := len(jogger.workCh)
+	r.stats.updateQueue(qlen)
+	if qlen >= r.effectiveBurst() {
+		r.alarms.raise(ECAlarmQueueOverflow, fmt.Sprintf("mpath=%s qlen=%d", jogger.mpath, qlen))
+		r.sliceTimeouts.markBusy(jogger.mpath)
+	}
 	jogger.workCh <- req
 	return nil
 }
 
+// effectiveBurst returns the configured per-jogger burst size, reduced by
+// `ecDegradedBurstDivisor` while one or more alarms are active. Existing
+// joggers' channel buffers aren't resized retroactively (Go channels are
+// fixed-size); the reduced value is applied to joggers created while
+// degraded, while the queue-overflow check in dispatchReq above provides
+// back-pressure for joggers that already exist.
+func (r *XactGet) effectiveBurst() int {
+	full := max(getxBurstSize, r.config.EC.Burst)
+	if r.alarms.degraded() {
+		return max(1, full/ecDegradedBurstDivisor)
+	}
+	return full
+}
+
 func (r *XactGet) Run(gowg *sync.WaitGroup) {
 	nlog.Infoln(r.Name())
 	for _, jog := range r.getJoggers {
@@ -198,6 +235,7 @@ func (r *XactGet) Run(gowg *sync.WaitGroup) {
 	for {
 		select {
 		case <-ticker.C:
+			r.checkClearAlarms()
 			if cmn.Rom.FastV(4, cos.SmoduleEC) {
 				if s := r.ECStats().String(); s != "" {
 					nlog.Infoln(s)
@@ -297,6 +335,7 @@ func (r *XactGet) addMpath(mpath string) {
 	getJog := r.newGetJogger(mpath)
 	r.getJoggers[mpath] = getJog
 	go getJog.run()
+	r.alarms.clear(ECAlarmMpathLoss)
 }
 
 func (r *XactGet) removeMpath(mpath string) {
@@ -320,7 +359,28 @@ func (r *XactGet) Snap() (snap *core.Snap) {
 		AvgObjTime:  cos.Duration(st.ObjTime),
 		AvgQueueLen: st.QueueLen,
 		IsIdle:      r.Pending() == 0,
+		Alarms:      r.alarms.list(),
 	}
 	snap.Stats.Objs = st.GetReq
 	return
 }
+
+// checkClearAlarms auto-clears ECAlarmQueueOverflow once every jogger's
+// queue has drained back below the low-water mark, and ECAlarmSliceTimeout
+// once no mountpath has stayed saturated long enough, providing the
+// hysteresis called for alongside the high-water raises in dispatchReq.
+func (r *XactGet) checkClearAlarms() {
+	var (
+		lowWater = max(1, r.effectiveBurst()/2)
+		qlens    = make([]int, 0, len(r.getJoggers))
+	)
+	for _, jog := range r.getJoggers {
+		qlen := len(jog.workCh)
+		qlens = append(qlens, qlen)
+		if qlen < lowWater {
+			r.sliceTimeouts.markIdle(jog.mpath)
+		}
+	}
+	clearQueueOverflow(&r.alarms, qlens, lowWater)
+	checkSliceTimeout(&r.alarms, &r.sliceTimeouts)
+}
@@ -0,0 +1,66 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import "testing"
+
+func newTestCheckpoint(totalSize, chunkSize int64) *blobDlCheckpoint {
+	n := int(totalSize / chunkSize)
+	if totalSize%chunkSize != 0 || n == 0 {
+		n++
+	}
+	return &blobDlCheckpoint{
+		ETag: "etag-1", Version: "v1",
+		ChunkSize: chunkSize, TotalSize: totalSize,
+		Chunks: make([]bool, n),
+	}
+}
+
+func TestBlobDlCheckpoint_MatchesRemote(t *testing.T) {
+	c := newTestCheckpoint(100, 40)
+
+	if !c.matchesRemote("etag-1", "v1", 100, false) {
+		t.Fatal("expected an unchanged remote object to match")
+	}
+	if c.matchesRemote("etag-2", "v1", 100, false) {
+		t.Fatal("expected a changed ETag to invalidate the checkpoint")
+	}
+	if c.matchesRemote("etag-1", "v1", 101, false) {
+		t.Fatal("expected a changed size to invalidate the checkpoint")
+	}
+	if !c.matchesRemote("etag-1", "v2", 100, false) {
+		t.Fatal("expected a version bump to be ignored when LatestVer is not set")
+	}
+	if c.matchesRemote("etag-1", "v2", 100, true) {
+		t.Fatal("expected a version bump to invalidate the checkpoint when LatestVer is set")
+	}
+}
+
+func TestBlobDlCheckpoint_ChunkLenAndBytesDone(t *testing.T) {
+	c := newTestCheckpoint(100, 40) // chunks of 40, 40, 20
+
+	if n := c.numChunks(); n != 3 {
+		t.Fatalf("expected 3 chunks, got %d", n)
+	}
+	if l := c.chunkLen(0); l != 40 {
+		t.Fatalf("expected chunk 0 to be 40 bytes, got %d", l)
+	}
+	if l := c.chunkLen(2); l != 20 {
+		t.Fatalf("expected the last (partial) chunk to be 20 bytes, got %d", l)
+	}
+	if c.bytesDone() != 0 {
+		t.Fatal("expected a fresh checkpoint to have nothing done")
+	}
+
+	c.markDone(0)
+	c.markDone(2)
+	if !c.chunkDone(0) || c.chunkDone(1) || !c.chunkDone(2) {
+		t.Fatal("markDone/chunkDone mismatch")
+	}
+	if got, want := c.bytesDone(), int64(40+20); got != want {
+		t.Fatalf("expected %d bytes done, got %d", want, got)
+	}
+}
@@ -6,6 +6,7 @@
 package xs
 
 import (
+	"fmt"
 	"sync"
 
 	"github.com/NVIDIA/aistore/api/apc"
@@ -23,11 +24,22 @@ type (
 		xreg.RenewBase
 		xctn *xactETL
 	}
+	// ETLInitArgs is the `xreg.Args.Custom` payload for `apc.ActETLInline`.
+	// The (optional) lease TTL a client attaches via `ais etl init
+	// --lease` is kept separate from `etl.InitSpecMsg` itself so that
+	// this lease/TTL mechanism doesn't require changing the wire message.
+	ETLInitArgs struct {
+		Msg      *etl.InitSpecMsg
+		LeaseTTL cos.Duration
+	}
 	// represents `apc.ActETLInline` kind of xaction (`apc.ActETLBck`/`apc.ActETLObject` kinds are managed by tcb/tcobjs)
 	// responsible for triggering global abort on error to ensure all related ETL resources are cleaned up across all targets.
 	xactETL struct {
 		msg *etl.InitSpecMsg
 		xact.Base
+
+		leaseMu sync.Mutex
+		lease   *etlLease // guarded by leaseMu; see etl_lease.go
 	}
 )
 
@@ -57,10 +69,23 @@ func (*etlFactory) WhenPrevIsRunning(xreg.Renewable) (xreg.WPR, error) {
 // (tests only)
 
 func newETL(p *etlFactory) *xactETL {
-	msg, ok := p.Args.Custom.(*etl.InitSpecMsg)
-	debug.Assert(ok)
-	xctn := &xactETL{msg: msg}
-	xctn.InitBase(p.Args.UUID, p.Kind(), msg.String(), nil)
+	var args ETLInitArgs
+	switch v := p.Args.Custom.(type) {
+	case *ETLInitArgs:
+		args = *v
+	case *etl.InitSpecMsg:
+		// a caller that hasn't been updated to attach a lease still just
+		// passes the bare spec message - keep that working rather than
+		// panicking on it.
+		args = ETLInitArgs{Msg: v}
+	default:
+		debug.Assert(false, fmt.Sprintf("%s: unexpected ETL init args type %T", p.Kind(), p.Args.Custom))
+	}
+	xctn := &xactETL{msg: args.Msg}
+	xctn.InitBase(p.Args.UUID, p.Kind(), args.Msg.String(), nil)
+	if args.LeaseTTL > 0 {
+		xctn.lease = newETLLease(xctn, args.LeaseTTL)
+	}
 	return xctn
 }
 
@@ -73,3 +98,73 @@ func (r *xactETL) Snap() (snap *core.Snap) {
 	snap.IdleX = r.IsIdle()
 	return
 }
+
+// Grant starts (or restarts) a TTL-bound lease on an already running inline
+// ETL session, returning the lease UUID the client must present on
+// subsequent `api.ETLKeepAlive` calls. Mirrors etcd's `Lease.Grant`. Any
+// previously granted lease is revoked first so its lessor goroutine
+// doesn't keep ticking (and its checkpoint file doesn't linger) after
+// it's no longer "the" lease.
+func (r *xactETL) Grant(ttl cos.Duration) string {
+	r.leaseMu.Lock()
+	defer r.leaseMu.Unlock()
+	if r.lease != nil {
+		r.lease.revoke()
+	}
+	r.lease = newETLLease(r, ttl)
+	return r.lease.id
+}
+
+// Revoke releases the lease (if any) without waiting for it to expire,
+// e.g. on a graceful `ais etl stop`.
+func (r *xactETL) Revoke() {
+	r.leaseMu.Lock()
+	defer r.leaseMu.Unlock()
+	if r.lease == nil {
+		return
+	}
+	r.lease.revoke()
+	r.lease = nil
+}
+
+// TimeToLive reports the remaining TTL, or zero when there's no active lease.
+func (r *xactETL) TimeToLive() cos.Duration {
+	r.leaseMu.Lock()
+	l := r.lease
+	r.leaseMu.Unlock()
+	if l == nil {
+		return 0
+	}
+	return l.timeToLive()
+}
+
+// KeepAlive renews the current lease for another full TTL. uuid is either
+// the lease UUID returned by Grant or the xaction's own ID (the one
+// `api.ETLInit` returns). Called from the `api.ETLKeepAlive(uuid)` RPC handler.
+func (r *xactETL) KeepAlive(uuid string) error {
+	r.leaseMu.Lock()
+	l := r.lease
+	r.leaseMu.Unlock()
+	if l == nil {
+		return fmt.Errorf("%s: no active lease", r)
+	}
+	if uuid == r.ID() {
+		uuid = l.id
+	}
+	return l.renew(uuid)
+}
+
+// clearLease is called by a lease's own lessor goroutine when it expires
+// on its own (as opposed to being revoked), so `r.lease` doesn't keep
+// pointing at a dead lease. Returns false if expired is no longer the
+// current lease (e.g. a racing Grant already replaced it), telling the
+// caller its expiry no longer speaks for this xaction.
+func (r *xactETL) clearLease(expired *etlLease) bool {
+	r.leaseMu.Lock()
+	defer r.leaseMu.Unlock()
+	if r.lease != expired {
+		return false
+	}
+	r.lease = nil
+	return true
+}
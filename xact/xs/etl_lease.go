@@ -0,0 +1,226 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2018-2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+	"github.com/NVIDIA/aistore/cmn/debug"
+	"github.com/NVIDIA/aistore/cmn/nlog"
+)
+
+// Lease/TTL mechanism for `apc.ActETLInline`, modeled on etcd's lease
+// subsystem: a client starting an inline ETL session may attach a lease
+// with a TTL and is expected to renew it periodically via
+// `api.ETLKeepAlive(uuid)`. A background "lessor" goroutine watches the
+// lease and, on expiry, aborts the owning xaction through the same
+// global-abort cleanup path used for any other ETL failure - releasing
+// pods and other cluster-wide resources left behind by a crashed client.
+
+const (
+	etlLeaseMinTTL        = 5 * time.Second
+	etlLeaseCheckInterval = time.Second
+)
+
+// etlLeaseWorkDir is the target's workfs subdirectory used to persist
+// outstanding leases across a restart; set once during target init via
+// InitLeaseWorkDir.
+var etlLeaseWorkDir string
+
+// InitLeaseWorkDir sets the directory used to persist outstanding ETL
+// leases and runs RestoreETLLeases over it, which discards lease files
+// that expired while the target was down. Called once during target
+// startup, after workfs is available, analogous to how the blob-download
+// checkpoint directory is wired in (see InitBlobDlCheckpointDir).
+func InitLeaseWorkDir(dir string) {
+	etlLeaseWorkDir = dir
+	RestoreETLLeases()
+}
+
+type etlLease struct {
+	mtx     sync.Mutex
+	id      string
+	xctn    *xactETL
+	ttl     time.Duration
+	expires time.Time
+	stopCh  cos.StopCh
+	closed  bool // guards against double-closing stopCh on a double revoke()
+}
+
+// on-disk representation of an outstanding lease. RestoreETLLeases reads it
+// back on target restart only to discard expired leases; live ones are not
+// loaded back into any xaction.
+type etlLeaseState struct {
+	ID      string       `json:"lease_id"`
+	XactID  string       `json:"xid"`
+	TTL     cos.Duration `json:"ttl"`
+	Expires time.Time    `json:"expires"`
+}
+
+func newETLLease(xctn *xactETL, ttl cos.Duration) *etlLease {
+	d := time.Duration(ttl)
+	if d < etlLeaseMinTTL {
+		d = etlLeaseMinTTL
+	}
+	l := &etlLease{
+		id:      cos.GenUUID(),
+		xctn:    xctn,
+		ttl:     d,
+		expires: time.Now().Add(d),
+	}
+	l.stopCh.Init()
+	l.persist()
+	go l.run()
+	return l
+}
+
+func (l *etlLease) timeToLive() cos.Duration {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if left := time.Until(l.expires); left > 0 {
+		return cos.Duration(left)
+	}
+	return 0
+}
+
+func (l *etlLease) renew(uuid string) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if l.id != uuid {
+		return fmt.Errorf("%s: unknown lease %q (have %q)", l.xctn, uuid, l.id)
+	}
+	l.expires = time.Now().Add(l.ttl)
+	l.persistLocked()
+	return nil
+}
+
+// revoke is idempotent: it's safe to call more than once (e.g. once from
+// Grant replacing a still-live lease, once more from an explicit Revoke).
+func (l *etlLease) revoke() {
+	l.mtx.Lock()
+	if l.closed {
+		l.mtx.Unlock()
+		return
+	}
+	l.closed = true
+	l.mtx.Unlock()
+
+	l.stopCh.Close()
+	l.remove()
+}
+
+func (l *etlLease) run() {
+	ticker := time.NewTicker(etlLeaseCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			l.mtx.Lock()
+			expired := time.Now().After(l.expires)
+			l.mtx.Unlock()
+			if !expired {
+				continue
+			}
+			l.remove()
+			if !l.xctn.clearLease(l) {
+				// superseded by a newer Grant racing with this tick - that
+				// lease owns the xaction's fate now, not this one
+				return
+			}
+			err := fmt.Errorf("%s: lease %q expired - aborting", l.xctn, l.id)
+			nlog.Warningln(err)
+			l.xctn.Abort(err) // same global-abort cleanup path as any other ETL error
+			return
+		case <-l.stopCh.Listen():
+			return
+		case <-l.xctn.ChanAbort():
+			return
+		}
+	}
+}
+
+//
+// persistence: one file per outstanding lease, rewritten on every renew
+// and removed on revoke or expiry
+//
+
+func (l *etlLease) path() string {
+	debug.Assert(etlLeaseWorkDir != "", "etl lease persistence not configured")
+	return filepath.Join(etlLeaseWorkDir, l.id+".lease")
+}
+
+func (l *etlLease) persist() {
+	l.mtx.Lock()
+	l.persistLocked()
+	l.mtx.Unlock()
+}
+
+func (l *etlLease) persistLocked() {
+	if etlLeaseWorkDir == "" {
+		return // persistence not configured, e.g. in unit tests
+	}
+	state := etlLeaseState{ID: l.id, XactID: l.xctn.ID(), TTL: cos.Duration(l.ttl), Expires: l.expires}
+	b, err := json.Marshal(&state)
+	if err != nil {
+		nlog.Errorln("failed to marshal", l.xctn.Name(), "lease", l.id, "err:", err)
+		return
+	}
+	if err := os.WriteFile(l.path(), b, 0o644); err != nil {
+		nlog.Errorln("failed to persist", l.xctn.Name(), "lease", l.id, "err:", err)
+	}
+}
+
+func (l *etlLease) remove() {
+	if etlLeaseWorkDir == "" {
+		return
+	}
+	if err := os.Remove(l.path()); err != nil && !os.IsNotExist(err) {
+		nlog.Errorln("failed to remove", l.xctn.Name(), "lease", l.id, "err:", err)
+	}
+}
+
+// RestoreETLLeases is called once during target startup, after
+// etlLeaseWorkDir has been set, to scan the lease files left over from
+// before the restart. Files of leases that expired while the target was
+// down are removed immediately rather than waiting to be discovered by the
+// (now absent) lessor goroutine. Files of leases still live are left in
+// place and not loaded: no xaction gets its lease back from them.
+func RestoreETLLeases() {
+	if etlLeaseWorkDir == "" {
+		return
+	}
+	entries, err := os.ReadDir(etlLeaseWorkDir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			nlog.Errorln("failed to scan etl lease dir:", err)
+		}
+		return
+	}
+	for _, e := range entries {
+		fqn := filepath.Join(etlLeaseWorkDir, e.Name())
+		b, err := os.ReadFile(fqn)
+		if err != nil {
+			nlog.Errorln("failed to read etl lease state", fqn, "err:", err)
+			continue
+		}
+		var state etlLeaseState
+		if err := json.Unmarshal(b, &state); err != nil {
+			nlog.Errorln("failed to parse etl lease state", fqn, "err:", err)
+			continue
+		}
+		if time.Now().After(state.Expires) {
+			nlog.Warningln("etl lease", state.ID, "for", state.XactID, "expired while target was down - discarding")
+			os.Remove(fqn)
+		}
+		// else: left in place, unused (see above)
+	}
+}
@@ -0,0 +1,67 @@
+// Package xs is a collection of eXtended actions (xactions), including multi-object
+// operations, list-objects, (cluster) rebalance and (target) resilver, ETL, and more.
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION. All rights reserved.
+ */
+package xs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NVIDIA/aistore/cmn/cos"
+)
+
+func TestETLLease_RenewRejectsUnknownID(t *testing.T) {
+	l := &etlLease{id: "lease-1", ttl: time.Minute, expires: time.Now().Add(time.Minute)}
+	if err := l.renew("lease-2"); err == nil {
+		t.Fatal("expected renew with the wrong id to fail")
+	}
+}
+
+func TestETLLease_RenewExtendsExpiry(t *testing.T) {
+	l := &etlLease{id: "lease-1", ttl: 50 * time.Millisecond, expires: time.Now().Add(-time.Second)}
+	if err := l.renew("lease-1"); err != nil {
+		t.Fatalf("renew: %v", err)
+	}
+	if left := time.Until(l.expires); left <= 0 {
+		t.Fatalf("expected renew to push expiry into the future, left=%v", left)
+	}
+}
+
+func TestETLLease_RevokeIsIdempotent(t *testing.T) {
+	l := &etlLease{id: "lease-1", ttl: time.Minute, expires: time.Now().Add(time.Minute)}
+	l.stopCh.Init()
+	l.revoke()
+	l.revoke() // must not panic on a double close
+	if !l.closed {
+		t.Fatal("expected lease to be marked closed")
+	}
+}
+
+func TestXactETL_GrantRevokesPriorLease(t *testing.T) {
+	xctn := &xactETL{}
+	id1 := xctn.Grant(cos.Duration(time.Minute))
+	first := xctn.lease
+
+	id2 := xctn.Grant(cos.Duration(time.Minute))
+	if id1 == id2 {
+		t.Fatal("expected a fresh lease id on re-Grant")
+	}
+	if xctn.lease == first {
+		t.Fatal("expected Grant to install a new lease, not keep the old one")
+	}
+	if !first.closed {
+		t.Fatal("expected the prior lease to be revoked (closed) once replaced")
+	}
+}
+
+func TestXactETL_KeepAliveNoLease(t *testing.T) {
+	xctn := &xactETL{}
+	if err := xctn.KeepAlive("whatever"); err == nil {
+		t.Fatal("expected KeepAlive with no active lease to fail")
+	}
+	if ttl := xctn.TimeToLive(); ttl != 0 {
+		t.Fatalf("expected zero TTL with no active lease, got %v", ttl)
+	}
+}